@@ -0,0 +1,117 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/tg"
+)
+
+// albumFlushDelay is how long an album waits for more members before it is downloaded
+const albumFlushDelay = 2 * time.Second
+
+// albumItem is a single media message that belongs to an album
+type albumItem struct {
+	messageID int
+	file      *mediaFile
+}
+
+// album collects the members of a grouped media message
+type album struct {
+	peer  tg.InputPeerClass
+	items []albumItem
+	timer *time.Timer
+}
+
+// albumBuffer groups album members by GroupedID until no new member arrives
+// for albumFlushDelay, then downloads the whole album at once
+type albumBuffer struct {
+	mu     sync.Mutex
+	albums map[int64]*album
+}
+
+var albums = &albumBuffer{albums: make(map[int64]*album)}
+
+// add buffers an album member and (re)starts the flush timer of its album
+func (b *albumBuffer) add(ctx context.Context, client *telegram.Client, config *Config, groupID int64, peer tg.InputPeerClass, item albumItem) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	a, ok := b.albums[groupID]
+	if !ok {
+		a = &album{peer: peer}
+		a.timer = time.AfterFunc(albumFlushDelay, func() {
+			b.flush(ctx, client, config, groupID)
+		})
+		b.albums[groupID] = a
+	} else {
+		a.timer.Reset(albumFlushDelay)
+	}
+
+	a.items = append(a.items, item)
+	log.Printf("Buffered album %d item from message %d (%d items so far)", groupID, item.messageID, len(a.items))
+}
+
+// flush removes the album from the buffer and downloads all of its items
+func (b *albumBuffer) flush(ctx context.Context, client *telegram.Client, config *Config, groupID int64) {
+	b.mu.Lock()
+	a, ok := b.albums[groupID]
+	delete(b.albums, groupID)
+	b.mu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	// Members may arrive out of order, message IDs give the original order
+	slices.SortFunc(a.items, func(x, y albumItem) int {
+		return cmp.Compare(x.messageID, y.messageID)
+	})
+
+	downloadAlbum(ctx, client, config, groupID, a)
+}
+
+// downloadAlbum downloads every album item into a subfolder named after the
+// group ID, reporting aggregate progress in a single status message
+func downloadAlbum(ctx context.Context, client *telegram.Client, config *Config, groupID int64, a *album) {
+	total := len(a.items)
+	albumFolder := filepath.Join(config.DownloadFolder, strconv.FormatInt(groupID, 10))
+
+	log.Printf("Downloading album %d with %d files to %s", groupID, total, albumFolder)
+
+	messageID := sendStatusMessage(ctx, client, a.peer, fmt.Sprintf("📦 Album: %d files\n⏳ Starting download...", total))
+
+	if err := os.MkdirAll(albumFolder, 0755); err != nil {
+		log.Printf("Failed to create album folder %s: %v", albumFolder, err)
+		updateStatusMessage(ctx, client, a.peer, messageID, "❌ Error creating album folder\n💾 Check disk space and permissions")
+		return
+	}
+
+	var failed int
+	for i, item := range a.items {
+		header := fmt.Sprintf("📦 Album: file %d/%d\n", i+1, total)
+		if err := downloadDocument(ctx, client, item.file.location, item.file.name, albumFolder, item.file.size, a.peer, messageID, header); err != nil {
+			log.Printf("Error downloading album %d item %d/%d: %v", groupID, i+1, total, err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		updateStatusMessage(ctx, client, a.peer, messageID, fmt.Sprintf("⚠️ Album downloaded: %d/%d files\n❌ Failed: %d\n📁 Saved to: %s",
+			total-failed, total, failed, albumFolder))
+	} else {
+		updateStatusMessage(ctx, client, a.peer, messageID, fmt.Sprintf("✅ Album downloaded: %d/%d files\n📁 Saved to: %s",
+			total, total, albumFolder))
+	}
+
+	log.Printf("Album %d finished: %d/%d files downloaded", groupID, total-failed, total)
+}
@@ -449,33 +449,14 @@ func handleMessage(ctx context.Context, client *telegram.Client, entities tg.Ent
 		return nil
 	}
 
-	// Handle document messages only
-	media, ok := msg.Media.(*tg.MessageMediaDocument)
+	// Handle documents and photos only
+	file, ok := extractMedia(msg)
 	if !ok {
 		return nil
 	}
+	fileName, fileSize := file.name, file.size
 
-	doc, ok := media.Document.(*tg.Document)
-	if !ok {
-		return nil
-	}
-
-	// Get document attributes
-	var fileName string
-	var fileSize int64 = doc.Size
-
-	for _, attr := range doc.Attributes {
-		if nameAttr, ok := attr.(*tg.DocumentAttributeFilename); ok {
-			fileName = nameAttr.FileName
-			break
-		}
-	}
-
-	if fileName == "" {
-		fileName = fmt.Sprintf("document_%d", doc.ID)
-	}
-
-	log.Printf("Found document from user %d: %s (size: %d bytes)", senderUserID, fileName, fileSize)
+	log.Printf("Found file from user %d: %s (size: %d bytes)", senderUserID, fileName, fileSize)
 
 	// Check file type if restrictions are enabled
 	if len(config.AllowedTypes) > 0 {
@@ -516,33 +497,105 @@ func handleMessage(ctx context.Context, client *telegram.Client, entities tg.Ent
 		return fmt.Errorf("file size %d bytes exceeds maximum limit of %d bytes", fileSize, MaxFileSize)
 	}
 
+	// Album members are buffered and downloaded together once complete
+	if msg.GroupedID != 0 {
+		albums.add(ctx, client, config, msg.GroupedID, peer, albumItem{messageID: msg.ID, file: file})
+		return nil
+	}
+
 	// Send initial download message
-	sender := message.NewSender(client.API())
-	statusMsg := fmt.Sprintf("📥 Downloading: %s\n📊 Size: %s\n⏳ Starting download...", fileName, formatBytes(fileSize))
+	messageID := sendStatusMessage(ctx, client, peer, fmt.Sprintf("📥 Downloading: %s\n📊 Size: %s\n⏳ Starting download...", fileName, formatBytes(fileSize)))
 
-	upd, err := sender.To(peer).Text(ctx, statusMsg)
-	var messageID int
-	if err != nil {
-		log.Printf("Error sending status message: %v", err)
-		messageID = 0
-	} else {
-		// Extract message ID from the updates
-		switch u := upd.(type) {
-		case *tg.Updates:
-			if len(u.Updates) > 0 {
-				if msgUpdate, ok := u.Updates[0].(*tg.UpdateMessageID); ok {
-					messageID = msgUpdate.ID
-				}
+	// Download the document with progress updates
+	return downloadDocument(ctx, client, file.location, fileName, config.DownloadFolder, fileSize, peer, messageID, "")
+}
+
+// mediaFile describes a downloadable file attached to a message
+type mediaFile struct {
+	location tg.InputFileLocationClass
+	name     string
+	size     int64
+}
+
+// extractMedia resolves the file location, name and size for documents and photos
+func extractMedia(msg *tg.Message) (*mediaFile, bool) {
+	var location tg.InputFileLocationClass
+	var fileName string
+	var fileSize int64
+
+	switch media := msg.Media.(type) {
+	case *tg.MessageMediaDocument:
+		doc, ok := media.Document.(*tg.Document)
+		if !ok {
+			return nil, false
+		}
+
+		for _, attr := range doc.Attributes {
+			if nameAttr, ok := attr.(*tg.DocumentAttributeFilename); ok {
+				fileName = nameAttr.FileName
+				break
 			}
 		}
+
+		if fileName == "" {
+			fileName = fmt.Sprintf("document_%d", doc.ID)
+		}
+
+		fileSize = doc.Size
+		location = &tg.InputDocumentFileLocation{
+			ID:            doc.ID,
+			AccessHash:    doc.AccessHash,
+			FileReference: doc.FileReference,
+		}
+
+	case *tg.MessageMediaPhoto:
+		photo, ok := media.Photo.(*tg.Photo)
+		if !ok {
+			return nil, false
+		}
+
+		thumbType, size, ok := largestPhotoSize(photo.Sizes)
+		if !ok {
+			log.Printf("Photo %d has no downloadable size", photo.ID)
+			return nil, false
+		}
+
+		fileName = fmt.Sprintf("photo_%d.jpg", photo.ID)
+		fileSize = size
+		location = &tg.InputPhotoFileLocation{
+			ID:            photo.ID,
+			AccessHash:    photo.AccessHash,
+			FileReference: photo.FileReference,
+			ThumbSize:     thumbType,
+		}
+
+	default:
+		return nil, false
 	}
 
-	// Download the document with progress updates
-	err = downloadDocument(ctx, client, doc, fileName, config.DownloadFolder, fileSize, peer, messageID)
-	return err
+	return &mediaFile{location: location, name: fileName, size: fileSize}, true
+}
+
+// sendStatusMessage sends a new status message and returns its ID so that it
+// can be edited later. Returns 0 if the message could not be sent.
+func sendStatusMessage(ctx context.Context, client *telegram.Client, peer tg.InputPeerClass, text string) int {
+	sender := message.NewSender(client.API())
+	upd, err := sender.To(peer).Text(ctx, text)
+	if err != nil {
+		log.Printf("Error sending status message: %v", err)
+		return 0
+	}
+
+	// Extract message ID from the updates
+	if u, ok := upd.(*tg.Updates); ok && len(u.Updates) > 0 {
+		if msgUpdate, ok := u.Updates[0].(*tg.UpdateMessageID); ok {
+			return msgUpdate.ID
+		}
+	}
+	return 0
 }
 
-func downloadDocument(ctx context.Context, client *telegram.Client, doc *tg.Document, fileName, downloadFolder string, fileSize int64, peer tg.InputPeerClass, messageID int) error {
+func downloadDocument(ctx context.Context, client *telegram.Client, location tg.InputFileLocationClass, fileName, downloadFolder string, fileSize int64, peer tg.InputPeerClass, messageID int, statusHeader string) error {
 	// Sanitize filename
 	fileName = sanitizeFilename(fileName)
 	filePath := filepath.Join(downloadFolder, fileName)
@@ -552,14 +605,14 @@ func downloadDocument(ctx context.Context, client *telegram.Client, doc *tg.Docu
 	finalFileName := filepath.Base(filePath)
 
 	// Update status: starting download
-	updateStatusMessage(ctx, client, peer, messageID, fmt.Sprintf("📥 Downloading: %s\n📊 Size: %s\n🔄 Connecting...", finalFileName, formatBytes(fileSize)))
+	updateStatusMessage(ctx, client, peer, messageID, statusHeader+fmt.Sprintf("📥 Downloading: %s\n📊 Size: %s\n🔄 Connecting...", finalFileName, formatBytes(fileSize)))
 
 	log.Printf("Downloading file: %s", finalFileName)
 
 	// Create local file
 	outFile, err := os.Create(filePath)
 	if err != nil {
-		updateStatusMessage(ctx, client, peer, messageID, fmt.Sprintf("❌ Error creating file: %s\n💾 Check disk space and permissions", finalFileName))
+		updateStatusMessage(ctx, client, peer, messageID, statusHeader+fmt.Sprintf("❌ Error creating file: %s\n💾 Check disk space and permissions", finalFileName))
 		return fmt.Errorf("failed to create local file: %w", err)
 	}
 	defer outFile.Close()
@@ -574,17 +627,11 @@ func downloadDocument(ctx context.Context, client *telegram.Client, doc *tg.Docu
 		peer:       peer,
 		messageID:  messageID,
 		fileName:   finalFileName,
+		header:     statusHeader,
 		lastUpdate: time.Now(),
 		startTime:  time.Now(),
 	}
 
-	// Create file location
-	location := &tg.InputDocumentFileLocation{
-		ID:            doc.ID,
-		AccessHash:    doc.AccessHash,
-		FileReference: doc.FileReference,
-	}
-
 	// Download with progress tracking
 	_, err = d.Download(client.API(), location).
 		Stream(ctx, &progressWriter{
@@ -593,7 +640,7 @@ func downloadDocument(ctx context.Context, client *telegram.Client, doc *tg.Docu
 		})
 
 	if err != nil {
-		updateStatusMessage(ctx, client, peer, messageID, fmt.Sprintf("❌ Download failed: %s\n🌐 Network error occurred", finalFileName))
+		updateStatusMessage(ctx, client, peer, messageID, statusHeader+fmt.Sprintf("❌ Download failed: %s\n🌐 Network error occurred", finalFileName))
 		return fmt.Errorf("failed to download file: %w", err)
 	}
 
@@ -604,7 +651,7 @@ func downloadDocument(ctx context.Context, client *telegram.Client, doc *tg.Docu
 		avgSpeed = formatBytes(int64(float64(progress.Current)/duration.Seconds())) + "/s"
 	}
 
-	updateStatusMessage(ctx, client, peer, messageID, fmt.Sprintf("✅ Downloaded: %s\n📊 Size: %s\n⚡ Avg Speed: %s\n📁 Saved to: %s",
+	updateStatusMessage(ctx, client, peer, messageID, statusHeader+fmt.Sprintf("✅ Downloaded: %s\n📊 Size: %s\n⚡ Avg Speed: %s\n📁 Saved to: %s",
 		finalFileName, formatBytes(progress.Current), avgSpeed, downloadFolder))
 
 	log.Printf("Successfully downloaded: %s (%d bytes)", filePath, progress.Current)
@@ -619,6 +666,7 @@ type ProgressTracker struct {
 	peer       tg.InputPeerClass
 	messageID  int
 	fileName   string
+	header     string
 	lastUpdate time.Time
 	startTime  time.Time
 }
@@ -649,7 +697,7 @@ func (pt *ProgressTracker) updateProgress() {
 		status := fmt.Sprintf("📥 Downloading: %s\n🔄 Progress: %s downloaded\n⏱️ In progress...",
 			pt.fileName,
 			formatBytes(pt.Current))
-		updateStatusMessage(ctx, pt.client, pt.peer, pt.messageID, status)
+		updateStatusMessage(ctx, pt.client, pt.peer, pt.messageID, pt.header+status)
 		return
 	}
 
@@ -676,7 +724,7 @@ func (pt *ProgressTracker) updateProgress() {
 		formatBytes(pt.Total),
 		eta)
 
-	updateStatusMessage(ctx, pt.client, pt.peer, pt.messageID, status)
+	updateStatusMessage(ctx, pt.client, pt.peer, pt.messageID, pt.header+status)
 }
 
 func updateStatusMessage(ctx context.Context, client *telegram.Client, peer tg.InputPeerClass, messageID int, text string) {
@@ -830,6 +878,41 @@ func isAllowedFileType(filename string, allowedExtensions []string) bool {
 	return slices.Contains(allowedExtensions, ext)
 }
 
+// largestPhotoSize picks the highest resolution size of a photo and returns
+// its type (used as ThumbSize in the file location) and size in bytes.
+// Cached, stripped and path sizes are inline previews and are skipped.
+func largestPhotoSize(sizes []tg.PhotoSizeClass) (string, int64, bool) {
+	var bestType string
+	var bestSize int64
+	bestPixels := -1
+
+	for _, size := range sizes {
+		var sizeType string
+		var pixels int
+		var bytes int64
+
+		switch s := size.(type) {
+		case *tg.PhotoSize:
+			sizeType, pixels, bytes = s.Type, s.W*s.H, int64(s.Size)
+		case *tg.PhotoSizeProgressive:
+			// Progressive sizes list the byte offset of each scan, the last
+			// one being the full image.
+			if len(s.Sizes) == 0 {
+				continue
+			}
+			sizeType, pixels, bytes = s.Type, s.W*s.H, int64(slices.Max(s.Sizes))
+		default:
+			continue
+		}
+
+		if pixels > bestPixels {
+			bestType, bestSize, bestPixels = sizeType, bytes, pixels
+		}
+	}
+
+	return bestType, bestSize, bestPixels >= 0
+}
+
 func sanitizeFilename(filename string) string {
 	invalidChars := []string{"/", "\\", ":", "*", "?", "\"", "<", ">", "|"}
 	for _, char := range invalidChars {